@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// BatchItem is one statement queued into a batch sent with SendBatch.
+type BatchItem struct {
+	SQL  string
+	Args []interface{}
+}
+
+// SendBatch queues items into a pgx.Batch and sends it in a single
+// round-trip. The returned pgx.BatchResults yields each item's result, in
+// order, via QueryRow/Query/Exec.
+func (w *Wrapper) SendBatch(ctx context.Context, items []BatchItem) pgx.BatchResults {
+	return w.Pool.SendBatch(ctx, newBatch(items))
+}
+
+// SendBatch queues items into a pgx.Batch and sends it within the
+// transaction in a single round-trip.
+func (tw *TxWrapper) SendBatch(ctx context.Context, items []BatchItem) pgx.BatchResults {
+	return tw.Tx.SendBatch(ctx, newBatch(items))
+}
+
+func newBatch(items []BatchItem) *pgx.Batch {
+	batch := &pgx.Batch{}
+	for _, item := range items {
+		batch.Queue(item.SQL, item.Args...)
+	}
+	return batch
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// copyFromExecutor is satisfied by both *pgxpool.Pool and pgx.Tx, letting
+// CopyFromStructs run against either a pool or an in-progress transaction.
+type copyFromExecutor interface {
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// CopyFromStructs bulk-loads rows (a slice of structs or struct pointers)
+// into tableName via pgx's COPY protocol, deriving column names from the
+// element type with the same mapper rules as Get/Select.
+func (w *Wrapper) CopyFromStructs(ctx context.Context, tableName string, rows interface{}) (int64, error) {
+	return copyFromStructs(ctx, w.Pool, w.mapper, tableName, rows)
+}
+
+// CopyFromStructs bulk-loads rows (a slice of structs or struct pointers)
+// into tableName via pgx's COPY protocol within the transaction, deriving
+// column names from the element type with the same mapper rules as
+// Get/Select.
+func (tw *TxWrapper) CopyFromStructs(ctx context.Context, tableName string, rows interface{}) (int64, error) {
+	return copyFromStructs(ctx, tw.Tx, tw.mapper, tableName, rows)
+}
+
+func copyFromStructs(ctx context.Context, copier copyFromExecutor, mapper *Mapper, tableName string, rows interface{}) (int64, error) {
+	rv := reflect.ValueOf(rows)
+	if rv.Kind() != reflect.Slice {
+		return 0, errRowsNotSlice
+	}
+
+	elemType := rv.Type().Elem()
+	ptrToStruct := false
+	if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct {
+		ptrToStruct = true
+		elemType = elemType.Elem()
+	} else if elemType.Kind() != reflect.Struct {
+		return 0, errRowsNotSlice
+	}
+
+	var columns []string
+	mapper.CollectColumnNames(reflect.New(elemType).Elem(), "", &columns)
+
+	plan, err := planFor(mapper, elemType, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	src := &structCopyFromSource{
+		rows:        rv,
+		plan:        plan,
+		ptrToStruct: ptrToStruct,
+		index:       -1,
+	}
+
+	return copier.CopyFrom(ctx, pgx.Identifier{tableName}, columns, src)
+}
+
+// structCopyFromSource is a pgx.CopyFromSource over a slice of structs or
+// struct pointers, pulling each row's values out via a plan (the same
+// column->field-index paths planFor computes for GetOne/SelectAll/Iterate)
+// instead of re-walking the struct by name on every row.
+type structCopyFromSource struct {
+	rows        reflect.Value
+	plan        [][]int
+	ptrToStruct bool
+	index       int
+}
+
+func (s *structCopyFromSource) Next() bool {
+	s.index++
+	return s.index < s.rows.Len()
+}
+
+func (s *structCopyFromSource) Values() ([]interface{}, error) {
+	elem := s.rows.Index(s.index)
+	if s.ptrToStruct {
+		if elem.IsNil() {
+			return nil, errNilStructPointerRow
+		}
+		elem = elem.Elem()
+	}
+
+	values := make([]interface{}, len(s.plan))
+	for i, idx := range s.plan {
+		values[i] = elem.FieldByIndex(idx).Interface()
+	}
+
+	return values, nil
+}
+
+func (s *structCopyFromSource) Err() error {
+	return nil
+}
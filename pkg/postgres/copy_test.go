@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStructCopyFromSourceValuesRejectsNilPointerRow(t *testing.T) {
+	type row struct {
+		ID int `db:"id"`
+	}
+
+	plan, err := planFor(DefaultMapper, reflect.TypeOf(row{}), []string{"id"})
+	if err != nil {
+		t.Fatalf("planFor returned error: %v", err)
+	}
+
+	rows := []*row{{ID: 1}, nil}
+	src := &structCopyFromSource{
+		rows:        reflect.ValueOf(rows),
+		plan:        plan,
+		ptrToStruct: true,
+		index:       -1,
+	}
+
+	if !src.Next() {
+		t.Fatal("expected a first row")
+	}
+	if _, err := src.Values(); err != nil {
+		t.Fatalf("Values() on a non-nil row returned error: %v", err)
+	}
+
+	if !src.Next() {
+		t.Fatal("expected a second row")
+	}
+	if _, err := src.Values(); err != errNilStructPointerRow {
+		t.Fatalf("Values() on a nil row = %v, want errNilStructPointerRow", err)
+	}
+}
@@ -0,0 +1,173 @@
+// Package postgres's generic query helpers require the standard library
+// "iter" package, introduced in Go 1.23; go.mod pins that minimum for the
+// module.
+package postgres
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// planCache memoizes, per (Mapper, struct type, result column set), the
+// field-index path for each column. It replaces the recursive
+// Mapper.CollectFields walk that Get/Select otherwise repeat for every
+// single row.
+var planCache sync.Map // map[planCacheKey][][]int
+
+type planCacheKey struct {
+	typ     reflect.Type
+	mapper  *Mapper
+	columns string
+}
+
+// planFor returns the cached field-index path for each of columns against t
+// under mapper's column-name rules, building and storing it on first use.
+// Keying the cache by mapper as well as type means two Wrappers configured
+// with different Mappers (different tag name, separator, ...) never share a
+// stale plan.
+func planFor(mapper *Mapper, t reflect.Type, columns []string) ([][]int, error) {
+	key := planCacheKey{typ: t, mapper: mapper, columns: strings.Join(columns, ",")}
+	if cached, ok := planCache.Load(key); ok {
+		return cached.([][]int), nil
+	}
+
+	fieldMap := make(map[string][]int)
+	mapper.FieldIndexPaths(t, "", nil, fieldMap)
+
+	plan := make([][]int, len(columns))
+	for i, col := range columns {
+		idx, ok := fieldMap[col]
+		if !ok {
+			return nil, newNoMatchingFieldError(col)
+		}
+		plan[i] = idx
+	}
+
+	planCache.Store(key, plan)
+	return plan, nil
+}
+
+// scanRowInto scans the current row of rows into a freshly constructed T,
+// using the cached plan for T's column layout under mapper's rules.
+func scanRowInto[T any](rows pgx.Rows, mapper *Mapper) (T, error) {
+	var dest T
+
+	t := reflect.TypeOf(dest)
+	if t == nil || t.Kind() != reflect.Struct {
+		return dest, errors.New("postgres: generic type parameter must be a struct")
+	}
+
+	fieldDescriptions := rows.FieldDescriptions()
+	columns := make([]string, len(fieldDescriptions))
+	for i, fd := range fieldDescriptions {
+		columns[i] = string(fd.Name)
+	}
+
+	plan, err := planFor(mapper, t, columns)
+	if err != nil {
+		return dest, err
+	}
+
+	rv := reflect.New(t).Elem()
+	fields := make([]interface{}, len(plan))
+	for i, idx := range plan {
+		fields[i] = rv.FieldByIndex(idx).Addr().Interface()
+	}
+
+	if err := rows.Scan(fields...); err != nil {
+		return dest, err
+	}
+
+	return rv.Interface().(T), nil
+}
+
+// GetOne runs sqlStr against db and scans the single resulting row into a T,
+// matching columns to T's fields via mapper (use DefaultMapper, or a
+// Wrapper/TxWrapper's Mapper() to stay consistent with its Get/Select
+// behavior). It returns pgx.ErrNoRows if the query yields no rows.
+func GetOne[T any](ctx context.Context, db DB, mapper *Mapper, sqlStr string, args ...interface{}) (T, error) {
+	var zero T
+
+	rows, err := db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return zero, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return zero, err
+		}
+		return zero, pgx.ErrNoRows
+	}
+
+	val, err := scanRowInto[T](rows, mapper)
+	if err != nil {
+		return zero, err
+	}
+
+	return val, rows.Err()
+}
+
+// SelectAll runs sqlStr against db and scans every resulting row into a T,
+// matching columns to T's fields via mapper (use DefaultMapper, or a
+// Wrapper/TxWrapper's Mapper() to stay consistent with its Get/Select
+// behavior).
+func SelectAll[T any](ctx context.Context, db DB, mapper *Mapper, sqlStr string, args ...interface{}) ([]T, error) {
+	rows, err := db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []T
+	for rows.Next() {
+		val, err := scanRowInto[T](rows, mapper)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, val)
+	}
+
+	return results, rows.Err()
+}
+
+// Iterate runs sqlStr against db and streams the results as a T, error
+// sequence, scanning one row at a time instead of materializing the full
+// slice that SelectAll would. Columns are matched to T's fields via mapper
+// (use DefaultMapper, or a Wrapper/TxWrapper's Mapper() to stay consistent
+// with its Get/Select behavior). Stop ranging to close the underlying rows
+// early.
+func Iterate[T any](ctx context.Context, db DB, mapper *Mapper, sqlStr string, args ...interface{}) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		rows, err := db.Query(ctx, sqlStr, args...)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			val, err := scanRowInto[T](rows, mapper)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(val, nil) {
+				return
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
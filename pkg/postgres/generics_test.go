@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanForCachesByTypeMapperAndColumns(t *testing.T) {
+	type row struct {
+		ID   int    `db:"id" json:"id"`
+		Name string `db:"name" json:"name"`
+	}
+	t1 := reflect.TypeOf(row{})
+
+	plan1, err := planFor(DefaultMapper, t1, []string{"id", "name"})
+	if err != nil {
+		t.Fatalf("planFor returned error: %v", err)
+	}
+
+	plan2, err := planFor(DefaultMapper, t1, []string{"id", "name"})
+	if err != nil {
+		t.Fatalf("planFor returned error: %v", err)
+	}
+	if &plan1[0] != &plan2[0] {
+		t.Fatal("expected the second planFor call to return the cached plan, got a freshly built one")
+	}
+
+	otherMapper := NewMapper()
+	otherMapper.TagName = "json"
+	plan3, err := planFor(otherMapper, t1, []string{"id", "name"})
+	if err != nil {
+		t.Fatalf("planFor returned error: %v", err)
+	}
+	if &plan3[0] == &plan1[0] {
+		t.Fatal("expected a different Mapper to bypass the cached plan built for DefaultMapper")
+	}
+
+	plan4, err := planFor(DefaultMapper, t1, []string{"name", "id"})
+	if err != nil {
+		t.Fatalf("planFor returned error: %v", err)
+	}
+	if &plan4[0] == &plan1[0] {
+		t.Fatal("expected a different column order to bypass the cached plan built for [id name]")
+	}
+}
+
+func TestPlanForUnknownColumn(t *testing.T) {
+	type row struct {
+		ID int `db:"id"`
+	}
+
+	_, err := planFor(DefaultMapper, reflect.TypeOf(row{}), []string{"missing"})
+	if err == nil {
+		t.Fatal("expected an error for a column with no matching struct field, got nil")
+	}
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Hook observes every QueryRow/Query/Exec call issued through a Wrapper or
+// TxWrapper (including those made by Get, Select and the Named* methods),
+// without the call sites needing to know it exists. SendBatch,
+// CopyFromStructs, Begin, Commit and Rollback go straight to the pool/tx and
+// are not observed. BeforeQuery runs just before the query is dispatched and
+// may return a derived context (e.g. carrying a span or a start time) that is
+// threaded through to the matching AfterQuery call. AfterQuery runs once the
+// query has been dispatched: for Exec, rowsAffected and err reflect the real
+// outcome; for Query, err only reflects failure to start the query (row
+// iteration errors happen after the hook has already returned); for QueryRow,
+// err is always nil, since its errors only surface at Scan. For both
+// QueryRow and Query, rowsAffected is -1.
+type Hook interface {
+	BeforeQuery(ctx context.Context, sql string, args []interface{}) context.Context
+	AfterQuery(ctx context.Context, sql string, args []interface{}, rowsAffected int64, err error)
+}
+
+// hookedExecutor wraps a DB so every QueryRow/Query/Exec call runs the
+// configured hooks before and after. It satisfies DB itself, so it can stand
+// in for the pool/tx a Wrapper or TxWrapper would otherwise call directly.
+type hookedExecutor struct {
+	inner DB
+	hooks []Hook
+}
+
+// wrapExecutor returns db unchanged when there are no hooks, so the
+// no-hooks path has zero overhead.
+func wrapExecutor(db DB, hooks []Hook) DB {
+	if len(hooks) == 0 {
+		return db
+	}
+	return &hookedExecutor{inner: db, hooks: hooks}
+}
+
+func (h *hookedExecutor) before(ctx context.Context, sql string, args []interface{}) context.Context {
+	for _, hook := range h.hooks {
+		ctx = hook.BeforeQuery(ctx, sql, args)
+	}
+	return ctx
+}
+
+func (h *hookedExecutor) after(ctx context.Context, sql string, args []interface{}, rowsAffected int64, err error) {
+	for _, hook := range h.hooks {
+		hook.AfterQuery(ctx, sql, args, rowsAffected, err)
+	}
+}
+
+func (h *hookedExecutor) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx = h.before(ctx, sql, args)
+	row := h.inner.QueryRow(ctx, sql, args...)
+	h.after(ctx, sql, args, -1, nil)
+	return row
+}
+
+func (h *hookedExecutor) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx = h.before(ctx, sql, args)
+	rows, err := h.inner.Query(ctx, sql, args...)
+	h.after(ctx, sql, args, -1, err)
+	return rows, err
+}
+
+func (h *hookedExecutor) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx = h.before(ctx, sql, args)
+	tag, err := h.inner.Exec(ctx, sql, args...)
+	h.after(ctx, sql, args, tag.RowsAffected(), err)
+	return tag, err
+}
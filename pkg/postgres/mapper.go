@@ -0,0 +1,199 @@
+package postgres
+
+import "reflect"
+
+// Mapper controls how Get, Select, the Named* methods, and the
+// CollectFields/CollectColumnNames helpers translate struct fields into
+// column names. It mirrors sqlx's reflectx.Mapper: the tag name, the
+// fallback transform applied to untagged fields, the separator used to join
+// nested-struct prefixes, and whether embedded structs flatten into their
+// parent are all configurable instead of hardcoded.
+type Mapper struct {
+	// TagName is the struct tag key consulted for a field's column name.
+	TagName string
+	// NameMapper transforms a Go field name into a column name when the
+	// field carries no TagName tag. Defaults to the identity function.
+	NameMapper func(fieldName string) string
+	// Separator joins a nested struct's prefix with its fields' names.
+	Separator string
+	// FlattenEmbedded, when true, names an anonymous/embedded field by its
+	// own tag (or transformed field name) alone, without the enclosing
+	// struct's prefix. The embedded struct's own fields are still prefixed
+	// with that name and Separator; they are not promoted to bare column
+	// names.
+	FlattenEmbedded bool
+}
+
+// NewMapper returns a Mapper with the library's historical defaults: the
+// "db" tag, "_"-joined nested prefixes, identity name transform, and
+// FlattenEmbedded enabled.
+func NewMapper() *Mapper {
+	return &Mapper{
+		TagName:         "db",
+		NameMapper:      func(fieldName string) string { return fieldName },
+		Separator:       "_",
+		FlattenEmbedded: true,
+	}
+}
+
+// DefaultMapper is the Mapper used by the package-level CollectFields,
+// CollectColumnNames, GetColumnNames, and StructFieldsPointers functions, and
+// by any Wrapper/TxWrapper created without an explicit Mapper.
+var DefaultMapper = NewMapper()
+
+// fieldColumnTag returns the tag value to use for field, and whether field
+// should be skipped entirely (TagName tag set to "-").
+func (m *Mapper) fieldColumnTag(field reflect.StructField) (tag string, skip bool) {
+	tag = field.Tag.Get(m.TagName)
+	if tag == "-" {
+		return "", true
+	}
+	if tag == "" {
+		tag = m.NameMapper(field.Name)
+	}
+	return tag, false
+}
+
+// columnName combines a nested-struct prefix with a field's tag according to
+// Separator and FlattenEmbedded.
+func (m *Mapper) columnName(prefix, tag string, anonymous bool) string {
+	if prefix == "" {
+		return tag
+	}
+	if anonymous && m.FlattenEmbedded {
+		return tag
+	}
+	return prefix + m.Separator + tag
+}
+
+// CollectFields recursively collects fields, including nested structs, into
+// fieldMap keyed by column name.
+func (m *Mapper) CollectFields(v reflect.Value, prefix string, fieldMap map[string]reflect.Value) {
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+
+		if !fieldValue.CanSet() {
+			continue
+		}
+
+		tag, skip := m.fieldColumnTag(field)
+		if skip {
+			if fieldValue.Kind() == reflect.Struct {
+				m.CollectFields(fieldValue, prefix, fieldMap)
+			}
+			continue
+		}
+
+		colName := m.columnName(prefix, tag, field.Anonymous)
+
+		if fieldValue.Kind() == reflect.Struct {
+			m.CollectFields(fieldValue, colName, fieldMap)
+		} else {
+			fieldMap[colName] = fieldValue
+		}
+	}
+}
+
+// CollectColumnNames recursively collects column names from the struct
+// fields, in struct declaration order.
+func (m *Mapper) CollectColumnNames(v reflect.Value, prefix string, columns *[]string) {
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+
+		if !fieldValue.CanSet() {
+			continue
+		}
+
+		tag, skip := m.fieldColumnTag(field)
+		if skip {
+			if fieldValue.Kind() == reflect.Struct {
+				m.CollectColumnNames(fieldValue, prefix, columns)
+			}
+			continue
+		}
+
+		colName := m.columnName(prefix, tag, field.Anonymous)
+
+		if fieldValue.Kind() == reflect.Struct {
+			m.CollectColumnNames(fieldValue, colName, columns)
+		} else {
+			*columns = append(*columns, colName)
+		}
+	}
+}
+
+// FieldIndexPaths is the type-level analogue of CollectFields: instead of
+// collecting field values, it collects the reflect.Value.FieldByIndex path to
+// reach each column, keyed by column name. Operating on a reflect.Type rather
+// than a reflect.Value lets callers (GetOne/SelectAll/Iterate) compute this
+// once per (Mapper, type, column set) and cache it across rows and query
+// executions instead of walking the struct on every row.
+func (m *Mapper) FieldIndexPaths(t reflect.Type, prefix string, path []int, out map[string][]int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		fieldPath := make([]int, len(path)+1)
+		copy(fieldPath, path)
+		fieldPath[len(path)] = i
+
+		tag, skip := m.fieldColumnTag(field)
+		if skip {
+			if field.Type.Kind() == reflect.Struct {
+				m.FieldIndexPaths(field.Type, prefix, fieldPath, out)
+			}
+			continue
+		}
+
+		colName := m.columnName(prefix, tag, field.Anonymous)
+
+		if field.Type.Kind() == reflect.Struct {
+			m.FieldIndexPaths(field.Type, colName, fieldPath, out)
+		} else {
+			out[colName] = fieldPath
+		}
+	}
+}
+
+// ColumnNames returns the column names for the struct pointed to by dest, in
+// struct declaration order.
+func (m *Mapper) ColumnNames(dest interface{}) ([]string, error) {
+	var columns []string
+	destVal := reflect.ValueOf(dest)
+	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Struct {
+		return nil, errDestNotStructPointer
+	}
+	m.CollectColumnNames(destVal.Elem(), "", &columns)
+	return columns, nil
+}
+
+// FieldPointers returns, for each of columns, a pointer to the matching
+// field of the struct pointed to by strct.
+func (m *Mapper) FieldPointers(strct interface{}, columns []string) ([]interface{}, error) {
+	v := reflect.ValueOf(strct)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, errInputNotStructPointer
+	}
+
+	fieldMap := make(map[string]reflect.Value)
+	m.CollectFields(v.Elem(), "", fieldMap)
+
+	fields := make([]interface{}, len(columns))
+	for i, col := range columns {
+		fieldVal, ok := fieldMap[col]
+		if !ok {
+			return nil, newNoMatchingFieldError(col)
+		}
+		fields[i] = fieldVal.Addr().Interface()
+	}
+
+	return fields, nil
+}
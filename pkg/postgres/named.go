@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// namedToken is a fragment of a parsed SQL string: either literal text to be
+// copied through unchanged, or the name of a `:field` placeholder to bind.
+type namedToken struct {
+	text    string
+	isParam bool
+}
+
+// parseNamedSQL splits sqlStr into literal and placeholder tokens. It
+// understands single- and double-quoted literals (including the doubled-quote
+// escape sequence) and PostgreSQL's `::` cast operator, so neither quoted
+// colons nor casts are mistaken for `:name` placeholders.
+func parseNamedSQL(sqlStr string) []namedToken {
+	var tokens []namedToken
+	var buf strings.Builder
+	runes := []rune(sqlStr)
+	n := len(runes)
+
+	flush := func() {
+		if buf.Len() > 0 {
+			tokens = append(tokens, namedToken{text: buf.String()})
+			buf.Reset()
+		}
+	}
+
+	for i := 0; i < n; {
+		c := runes[i]
+		switch {
+		case c == '\'' || c == '"':
+			quote := c
+			buf.WriteRune(c)
+			i++
+			for i < n {
+				buf.WriteRune(runes[i])
+				if runes[i] == quote {
+					i++
+					if i < n && runes[i] == quote {
+						buf.WriteRune(runes[i])
+						i++
+						continue
+					}
+					break
+				}
+				i++
+			}
+		case c == ':' && i+1 < n && runes[i+1] == ':':
+			buf.WriteString("::")
+			i += 2
+		case c == ':' && i+1 < n && isNamedIdentStart(runes[i+1]):
+			flush()
+			j := i + 1
+			for j < n && isNamedIdentPart(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, namedToken{text: string(runes[i+1 : j]), isParam: true})
+			i = j
+		default:
+			buf.WriteRune(c)
+			i++
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+func isNamedIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isNamedIdentPart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// namedArgSource resolves the values available for `:name` placeholders out
+// of a struct (using mapper's column-name rules, the same as CollectFields)
+// or a map[string]interface{}.
+func namedArgSource(mapper *Mapper, arg interface{}) (map[string]interface{}, error) {
+	if m, ok := arg.(map[string]interface{}); ok {
+		return m, nil
+	}
+
+	v := reflect.ValueOf(arg)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, errors.New("named argument must not be a nil pointer")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, errors.New("named argument must be a struct or map[string]interface{}")
+	}
+	if !v.CanAddr() {
+		// CollectFields relies on CanSet, which requires an addressable
+		// value; arg is passed by value (e.g. BindNamed(mapper, sql, user{...})),
+		// so copy it into an addressable one before walking its fields.
+		addr := reflect.New(v.Type())
+		addr.Elem().Set(v)
+		v = addr.Elem()
+	}
+
+	fieldMap := make(map[string]reflect.Value)
+	mapper.CollectFields(v, "", fieldMap)
+
+	values := make(map[string]interface{}, len(fieldMap))
+	for name, fv := range fieldMap {
+		values[name] = fv.Interface()
+	}
+	return values, nil
+}
+
+// BindNamed rewrites sqlStr's `:field` placeholders into pgx's positional
+// `$1, $2, ...` form and resolves the matching arguments from arg, which may
+// be a struct (matched via mapper's column-name rules, same as Get/Select) or
+// a map[string]interface{}. A placeholder bound to a slice is expanded into a
+// comma-separated list of positional parameters, e.g. `IN (:ids)` becomes
+// `IN ($1,$2,$3)`, so callers get sqlx-style `IN` support for free.
+func BindNamed(mapper *Mapper, sqlStr string, arg interface{}) (string, []interface{}, error) {
+	values, err := namedArgSource(mapper, arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tokens := parseNamedSQL(sqlStr)
+
+	var query strings.Builder
+	var args []interface{}
+	idx := 1
+
+	for _, tok := range tokens {
+		if !tok.isParam {
+			query.WriteString(tok.text)
+			continue
+		}
+
+		val, ok := values[tok.text]
+		if !ok {
+			return "", nil, fmt.Errorf("named parameter %q has no matching field or map key", tok.text)
+		}
+
+		rv := reflect.ValueOf(val)
+		if rv.IsValid() && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8 {
+			length := rv.Len()
+			if length == 0 {
+				return "", nil, fmt.Errorf("named parameter %q is an empty slice", tok.text)
+			}
+			for i := 0; i < length; i++ {
+				if i > 0 {
+					query.WriteByte(',')
+				}
+				query.WriteByte('$')
+				query.WriteString(strconv.Itoa(idx))
+				args = append(args, rv.Index(i).Interface())
+				idx++
+			}
+			continue
+		}
+
+		query.WriteByte('$')
+		query.WriteString(strconv.Itoa(idx))
+		args = append(args, val)
+		idx++
+	}
+
+	return query.String(), args, nil
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseNamedSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want []namedToken
+	}{
+		{
+			name: "simple placeholder",
+			sql:  "SELECT * FROM users WHERE id = :id",
+			want: []namedToken{
+				{text: "SELECT * FROM users WHERE id = "},
+				{text: "id", isParam: true},
+			},
+		},
+		{
+			name: "colon inside single-quoted literal is not a placeholder",
+			sql:  "SELECT ':not_a_param' AS label WHERE id = :id",
+			want: []namedToken{
+				{text: "SELECT ':not_a_param' AS label WHERE id = "},
+				{text: "id", isParam: true},
+			},
+		},
+		{
+			name: "colon inside double-quoted identifier is not a placeholder",
+			sql:  `SELECT "weird:column" FROM t WHERE id = :id`,
+			want: []namedToken{
+				{text: `SELECT "weird:column" FROM t WHERE id = `},
+				{text: "id", isParam: true},
+			},
+		},
+		{
+			name: "doubled quote escape inside literal",
+			sql:  "SELECT 'it''s :fine' WHERE id = :id",
+			want: []namedToken{
+				{text: "SELECT 'it''s :fine' WHERE id = "},
+				{text: "id", isParam: true},
+			},
+		},
+		{
+			name: "double-colon cast is not a placeholder",
+			sql:  "SELECT :id::text",
+			want: []namedToken{
+				{text: "SELECT "},
+				{text: "id", isParam: true},
+				{text: "::text"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseNamedSQL(tt.sql)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseNamedSQL(%q) = %#v, want %#v", tt.sql, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBindNamed(t *testing.T) {
+	t.Run("struct fields bound by column name", func(t *testing.T) {
+		type user struct {
+			ID   int    `db:"id"`
+			Name string `db:"name"`
+		}
+
+		query, args, err := BindNamed(DefaultMapper, "SELECT * FROM users WHERE id = :id AND name = :name", user{ID: 1, Name: "ann"})
+		if err != nil {
+			t.Fatalf("BindNamed returned error: %v", err)
+		}
+		if query != "SELECT * FROM users WHERE id = $1 AND name = $2" {
+			t.Fatalf("unexpected query: %q", query)
+		}
+		if !reflect.DeepEqual(args, []interface{}{1, "ann"}) {
+			t.Fatalf("unexpected args: %#v", args)
+		}
+	})
+
+	t.Run("slice expands into comma-separated positional params", func(t *testing.T) {
+		query, args, err := BindNamed(DefaultMapper, "SELECT * FROM users WHERE id IN (:ids)", map[string]interface{}{
+			"ids": []int{1, 2, 3},
+		})
+		if err != nil {
+			t.Fatalf("BindNamed returned error: %v", err)
+		}
+		if query != "SELECT * FROM users WHERE id IN ($1,$2,$3)" {
+			t.Fatalf("unexpected query: %q", query)
+		}
+		if !reflect.DeepEqual(args, []interface{}{1, 2, 3}) {
+			t.Fatalf("unexpected args: %#v", args)
+		}
+	})
+
+	t.Run("empty slice is an error", func(t *testing.T) {
+		_, _, err := BindNamed(DefaultMapper, "SELECT * FROM users WHERE id IN (:ids)", map[string]interface{}{
+			"ids": []int{},
+		})
+		if err == nil {
+			t.Fatal("expected an error for an empty slice, got nil")
+		}
+	})
+
+	t.Run("missing key is an error", func(t *testing.T) {
+		_, _, err := BindNamed(DefaultMapper, "SELECT * FROM users WHERE id = :id", map[string]interface{}{
+			"other": 1,
+		})
+		if err == nil {
+			t.Fatal("expected an error for a missing named parameter, got nil")
+		}
+	})
+
+	t.Run("byte slice is bound as a single value, not expanded", func(t *testing.T) {
+		query, args, err := BindNamed(DefaultMapper, "SELECT * FROM blobs WHERE data = :data", map[string]interface{}{
+			"data": []byte("abc"),
+		})
+		if err != nil {
+			t.Fatalf("BindNamed returned error: %v", err)
+		}
+		if query != "SELECT * FROM blobs WHERE data = $1" {
+			t.Fatalf("unexpected query: %q", query)
+		}
+		if len(args) != 1 {
+			t.Fatalf("expected a single bound arg, got %#v", args)
+		}
+	})
+}
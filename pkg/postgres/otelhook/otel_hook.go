@@ -0,0 +1,63 @@
+// Package otelhook provides an OpenTelemetry-backed postgres.Hook. It is
+// kept out of the core postgres package so that consumers who only need
+// Named*/SendBatch/etc. don't transitively pull in the OpenTelemetry
+// dependency tree; pull this package in only if you want tracing.
+package otelhook
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is used as the OpenTelemetry tracer/instrumentation
+// scope name for spans created by Hook.
+const instrumentationName = "github.com/Arlandaren/pgxWrappy"
+
+type otelSpanKey struct{}
+
+// Hook opens an OpenTelemetry span for every query, tagged with
+// db.system=postgresql and db.statement, and records the query's error (if
+// any) on the span. It implements postgres.Hook.
+type Hook struct {
+	Tracer trace.Tracer
+}
+
+// New returns a Hook using tracer, or the global tracer provider's tracer
+// for this package if tracer is nil.
+func New(tracer trace.Tracer) *Hook {
+	if tracer == nil {
+		tracer = otel.Tracer(instrumentationName)
+	}
+	return &Hook{Tracer: tracer}
+}
+
+// BeforeQuery opens a span for the query and attaches it to the returned context.
+func (h *Hook) BeforeQuery(ctx context.Context, sql string, _ []interface{}) context.Context {
+	ctx, span := h.Tracer.Start(ctx, "pgxWrappy.query", trace.WithAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", sql),
+	))
+	return context.WithValue(ctx, otelSpanKey{}, span)
+}
+
+// AfterQuery records rowsAffected and err on the span opened by BeforeQuery,
+// then ends it.
+func (h *Hook) AfterQuery(ctx context.Context, _ string, _ []interface{}, rowsAffected int64, err error) {
+	span, ok := ctx.Value(otelSpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if rowsAffected >= 0 {
+		span.SetAttributes(attribute.Int64("db.rows_affected", rowsAffected))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"reflect"
+)
+
+// Get executes sqlStr against db, expecting exactly one resulting row, and
+// scans it into dest (a pointer to a struct) using mapper's column-name
+// rules. It is the shared implementation behind Wrapper.Get and
+// TxWrapper.Get, which simply supply their own pool/tx as db.
+func Get(ctx context.Context, db DB, mapper *Mapper, dest interface{}, sqlStr string, args ...interface{}) error {
+	destVal := reflect.ValueOf(dest)
+	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Struct {
+		return errDestNotStructPointer
+	}
+
+	columns, err := mapper.ColumnNames(dest)
+	if err != nil {
+		return err
+	}
+
+	fields, err := mapper.FieldPointers(dest, columns)
+	if err != nil {
+		return err
+	}
+
+	row := db.QueryRow(ctx, sqlStr, args...)
+
+	return row.Scan(fields...)
+}
+
+// Select executes sqlStr against db and scans every resulting row into dest
+// (a pointer to a slice of structs or struct pointers) using mapper's
+// column-name rules. It is the shared implementation behind Wrapper.Select
+// and TxWrapper.Select, which simply supply their own pool/tx as db.
+func Select(ctx context.Context, db DB, mapper *Mapper, dest interface{}, sqlStr string, args ...interface{}) error {
+	rows, err := db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	destVal := reflect.ValueOf(dest)
+	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Slice {
+		return errDestNotSlicePointer
+	}
+
+	sliceVal := destVal.Elem()
+	elemType := sliceVal.Type().Elem()
+
+	ptrToStruct := false
+	if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct {
+		ptrToStruct = true
+		elemType = elemType.Elem()
+	} else if elemType.Kind() != reflect.Struct {
+		return errSliceElemNotStruct
+	}
+
+	fieldDescriptions := rows.FieldDescriptions()
+	columns := make([]string, len(fieldDescriptions))
+	for i, fd := range fieldDescriptions {
+		columns[i] = string(fd.Name)
+	}
+
+	for rows.Next() {
+		elemPtr := reflect.New(elemType)
+
+		fields, err := mapper.FieldPointers(elemPtr.Interface(), columns)
+		if err != nil {
+			return err
+		}
+
+		if err := rows.Scan(fields...); err != nil {
+			return err
+		}
+
+		if ptrToStruct {
+			sliceVal.Set(reflect.Append(sliceVal, elemPtr))
+		} else {
+			sliceVal.Set(reflect.Append(sliceVal, elemPtr.Elem()))
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// defaultSlogRedactThreshold is the argument size, in bytes, above which
+// SlogHook logs a placeholder instead of the value.
+const defaultSlogRedactThreshold = 1024
+
+type slogHookStartKey struct{}
+
+// SlogHook logs every query through a slog.Logger, redacting individual
+// string and []byte arguments longer than RedactThreshold bytes so large
+// payloads (blobs, big text or JSON strings) don't flood the log. Arguments
+// of any other type are logged as they are, whatever their size.
+type SlogHook struct {
+	Logger          *slog.Logger
+	RedactThreshold int
+}
+
+// NewSlogHook returns a SlogHook logging through logger, using
+// defaultSlogRedactThreshold to redact oversized arguments.
+func NewSlogHook(logger *slog.Logger) *SlogHook {
+	return &SlogHook{Logger: logger, RedactThreshold: defaultSlogRedactThreshold}
+}
+
+// BeforeQuery stashes the start time so AfterQuery can log the duration.
+func (h *SlogHook) BeforeQuery(ctx context.Context, _ string, _ []interface{}) context.Context {
+	return context.WithValue(ctx, slogHookStartKey{}, time.Now())
+}
+
+// AfterQuery logs the query at debug level, or at error level if err is set.
+func (h *SlogHook) AfterQuery(ctx context.Context, sql string, args []interface{}, rowsAffected int64, err error) {
+	attrs := []any{
+		slog.String("sql", sql),
+		slog.Any("args", h.redact(args)),
+	}
+	if start, ok := ctx.Value(slogHookStartKey{}).(time.Time); ok {
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+	}
+	if rowsAffected >= 0 {
+		attrs = append(attrs, slog.Int64("rows_affected", rowsAffected))
+	}
+
+	if err != nil {
+		h.Logger.ErrorContext(ctx, "pgxWrappy: query failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+	h.Logger.DebugContext(ctx, "pgxWrappy: query", attrs...)
+}
+
+func (h *SlogHook) redact(args []interface{}) []interface{} {
+	threshold := h.RedactThreshold
+	if threshold <= 0 {
+		threshold = defaultSlogRedactThreshold
+	}
+
+	redacted := make([]interface{}, len(args))
+	for i, arg := range args {
+		if argSize(arg) > threshold {
+			redacted[i] = "<redacted>"
+			continue
+		}
+		redacted[i] = arg
+	}
+	return redacted
+}
+
+func argSize(arg interface{}) int {
+	switch v := arg.(type) {
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	default:
+		return 0
+	}
+}
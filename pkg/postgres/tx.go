@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// InTx begins a transaction with txOptions, runs fn with a TxWrapper bound to
+// it, and commits if fn returns nil. If fn returns an error or panics, the
+// transaction is rolled back; a panic is re-raised after the rollback so the
+// caller's own recover (if any) still sees it.
+func (w *Wrapper) InTx(ctx context.Context, txOptions pgx.TxOptions, fn func(*TxWrapper) error) error {
+	tx, err := w.Pool.BeginTx(ctx, txOptions)
+	if err != nil {
+		return err
+	}
+	return runInTx(ctx, tx, w.mapper, w.hooks, fn)
+}
+
+// InTx runs fn inside a nested transaction scoped to tw, backed by a pgx
+// savepoint: pgx's Tx.Begin issues SAVEPOINT when called on an existing
+// transaction, and the matching Commit/Rollback issue RELEASE SAVEPOINT /
+// ROLLBACK TO SAVEPOINT. As with Wrapper.InTx, fn's error or panic rolls the
+// savepoint back instead of releasing it.
+func (tw *TxWrapper) InTx(ctx context.Context, fn func(*TxWrapper) error) error {
+	nested, err := tw.Tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	return runInTx(ctx, nested, tw.mapper, tw.hooks, fn)
+}
+
+// runInTx drives the commit-on-success, rollback-on-error-or-panic lifecycle
+// shared by Wrapper.InTx and TxWrapper.InTx.
+func runInTx(ctx context.Context, tx pgx.Tx, mapper *Mapper, hooks []Hook, fn func(*TxWrapper) error) (err error) {
+	tw := newTxWrapper(tx, mapper, hooks)
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tw.Rollback(ctx)
+			panic(p)
+		}
+		if err != nil {
+			_ = tw.Rollback(ctx)
+			return
+		}
+		err = tw.Commit(ctx)
+	}()
+
+	err = fn(tw)
+	return err
+}
@@ -2,8 +2,6 @@ package postgres
 
 import (
 	"context"
-	"errors"
-	"reflect"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -11,108 +9,89 @@ import (
 
 // TxWrapper is a wrapper for transactions
 type TxWrapper struct {
-	Tx pgx.Tx
+	Tx       pgx.Tx
+	mapper   *Mapper
+	hooks    []Hook
+	executor DB
+}
+
+// newTxWrapper builds a TxWrapper around tx, wiring up the hook-observing
+// executor that QueryRow/Query/Exec/Get/Select/Named* all go through.
+func newTxWrapper(tx pgx.Tx, mapper *Mapper, hooks []Hook) *TxWrapper {
+	return &TxWrapper{Tx: tx, mapper: mapper, hooks: hooks, executor: wrapExecutor(tx, hooks)}
+}
+
+// Mapper returns the column-name Mapper this TxWrapper scans and binds with,
+// for passing to the generic GetOne/SelectAll/Iterate helpers.
+func (tw *TxWrapper) Mapper() *Mapper {
+	return tw.mapper
 }
 
 // QueryRow is a wrapper for the QueryRow method within a transaction
 func (tw *TxWrapper) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
-	return tw.Tx.QueryRow(ctx, sql, args...)
+	return tw.executor.QueryRow(ctx, sql, args...)
 }
 
 // Query is a wrapper for the Query method within a transaction
 func (tw *TxWrapper) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
-	return tw.Tx.Query(ctx, sql, args...)
+	return tw.executor.Query(ctx, sql, args...)
 }
 
 // Exec is a wrapper for the Exec method within a transaction
 func (tw *TxWrapper) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
-	return tw.Tx.Exec(ctx, sql, args...)
+	return tw.executor.Exec(ctx, sql, args...)
 }
 
 // Get executes a query within a transaction that returns one row and scans it into a struct
 func (tw *TxWrapper) Get(ctx context.Context, dest interface{}, sqlStr string, args ...interface{}) error {
-	destVal := reflect.ValueOf(dest)
-	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Struct {
-		return errors.New("dest must be a pointer to a struct")
-	}
+	return Get(ctx, tw.executor, tw.mapper, dest, sqlStr, args...)
+}
 
-	// Get expected column names from the destination struct
-	columns, err := GetColumnNames(dest)
-	if err != nil {
-		return err
-	}
+// Select retrieves multiple results within a transaction and scans them into a slice
+func (tw *TxWrapper) Select(ctx context.Context, dest interface{}, sqlStr string, args ...interface{}) error {
+	return Select(ctx, tw.executor, tw.mapper, dest, sqlStr, args...)
+}
 
-	// Get pointers to the struct fields
-	fields, err := StructFieldsPointers(dest, columns)
+// NamedQuery executes a query whose SQL contains `:field` placeholders
+// within the transaction, binding them from arg (a struct or
+// map[string]interface{}) via BindNamed.
+func (tw *TxWrapper) NamedQuery(ctx context.Context, sqlStr string, arg interface{}) (pgx.Rows, error) {
+	query, args, err := BindNamed(tw.mapper, sqlStr, arg)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return tw.Query(ctx, query, args...)
+}
 
-	// Execute the query
-	row := tw.Tx.QueryRow(ctx, sqlStr, args...)
-
-	// Scan the data into the struct fields
-	if err := row.Scan(fields...); err != nil {
-		return err
+// NamedExec executes a statement whose SQL contains `:field` placeholders
+// within the transaction, binding them from arg (a struct or
+// map[string]interface{}) via BindNamed.
+func (tw *TxWrapper) NamedExec(ctx context.Context, sqlStr string, arg interface{}) (pgconn.CommandTag, error) {
+	query, args, err := BindNamed(tw.mapper, sqlStr, arg)
+	if err != nil {
+		return pgconn.CommandTag{}, err
 	}
-
-	return nil
+	return tw.Exec(ctx, query, args...)
 }
 
-// Select retrieves multiple results within a transaction and scans them into a slice
-func (tw *TxWrapper) Select(ctx context.Context, dest interface{}, sqlStr string, args ...interface{}) error {
-	rows, err := tw.Tx.Query(ctx, sqlStr, args...)
+// NamedGet is the named-parameter counterpart to Get: it binds sqlStr's
+// `:field` placeholders from arg before executing and scanning into dest.
+func (tw *TxWrapper) NamedGet(ctx context.Context, dest interface{}, sqlStr string, arg interface{}) error {
+	query, args, err := BindNamed(tw.mapper, sqlStr, arg)
 	if err != nil {
 		return err
 	}
-	defer rows.Close()
-
-	destVal := reflect.ValueOf(dest)
-	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Slice {
-		return errors.New("dest must be a pointer to a slice")
-	}
-
-	sliceVal := destVal.Elem()
-	elemType := sliceVal.Type().Elem()
-
-	ptrToStruct := false
-	if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct {
-		ptrToStruct = true
-		elemType = elemType.Elem()
-	} else if elemType.Kind() != reflect.Struct {
-		return errors.New("slice elements must be structs or pointers to structs")
-	}
-
-	fieldDescriptions := rows.FieldDescriptions()
-	columns := make([]string, len(fieldDescriptions))
-	for i, fd := range fieldDescriptions {
-		columns[i] = string(fd.Name)
-	}
-
-	for rows.Next() {
-		elemPtr := reflect.New(elemType)
-
-		fields, err := StructFieldsPointers(elemPtr.Interface(), columns)
-		if err != nil {
-			return err
-		}
-
-		if err := rows.Scan(fields...); err != nil {
-			return err
-		}
-
-		if ptrToStruct {
-			sliceVal.Set(reflect.Append(sliceVal, elemPtr))
-		} else {
-			sliceVal.Set(reflect.Append(sliceVal, elemPtr.Elem()))
-		}
-	}
+	return tw.Get(ctx, dest, query, args...)
+}
 
-	if err := rows.Err(); err != nil {
+// NamedSelect is the named-parameter counterpart to Select: it binds sqlStr's
+// `:field` placeholders from arg before executing and scanning into dest.
+func (tw *TxWrapper) NamedSelect(ctx context.Context, dest interface{}, sqlStr string, arg interface{}) error {
+	query, args, err := BindNamed(tw.mapper, sqlStr, arg)
+	if err != nil {
 		return err
 	}
-
-	return nil
+	return tw.Select(ctx, dest, query, args...)
 }
 
 // Commit commits the transaction
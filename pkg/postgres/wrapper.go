@@ -2,8 +2,6 @@ package postgres
 
 import (
 	"context"
-	"errors"
-	"reflect"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -19,113 +17,124 @@ type DB interface {
 
 // Wrapper is a structure that contains a connection pool to the database
 type Wrapper struct {
-	Pool *pgxpool.Pool
+	Pool     *pgxpool.Pool
+	mapper   *Mapper
+	hooks    []Hook
+	executor DB
 }
 
-// NewWrapper creates a new wrapper with a connection pool
+// NewWrapper creates a new wrapper with a connection pool, using DefaultMapper
+// for column-name mapping and no hooks.
 func NewWrapper(pool *pgxpool.Pool) *Wrapper {
-	return &Wrapper{Pool: pool}
+	return NewWrapperWithOptions(pool)
+}
+
+// WrapperWithHooks creates a new wrapper whose QueryRow/Query/Exec calls -
+// including those made indirectly through Get/Select/Named*/InTx - run
+// through hooks, in order, before and after. It is equivalent to
+// NewWrapperWithOptions(pool, WithHooks(hooks...)).
+func WrapperWithHooks(pool *pgxpool.Pool, hooks ...Hook) *Wrapper {
+	return NewWrapperWithOptions(pool, WithHooks(hooks...))
+}
+
+// Option configures a Wrapper created via NewWrapperWithOptions.
+type Option func(*Wrapper)
+
+// WithMapper overrides the column-name Mapper used by Get, Select, and the
+// Named* methods, letting callers adopt a different tag name, nested-prefix
+// separator, or untagged-field name transform instead of the "db"/"_"
+// defaults.
+func WithMapper(m *Mapper) Option {
+	return func(w *Wrapper) { w.mapper = m }
+}
+
+// WithHooks attaches hooks to the Wrapper, and to every TxWrapper it begins,
+// so they observe every QueryRow/Query/Exec call issued through either
+// (SendBatch and CopyFromStructs are not observed).
+func WithHooks(hooks ...Hook) Option {
+	return func(w *Wrapper) { w.hooks = append(w.hooks, hooks...) }
+}
+
+// NewWrapperWithOptions creates a new wrapper with a connection pool,
+// applying opts on top of DefaultMapper and no hooks.
+func NewWrapperWithOptions(pool *pgxpool.Pool, opts ...Option) *Wrapper {
+	w := &Wrapper{Pool: pool, mapper: DefaultMapper}
+	for _, opt := range opts {
+		opt(w)
+	}
+	w.executor = wrapExecutor(pool, w.hooks)
+	return w
+}
+
+// Mapper returns the column-name Mapper this Wrapper scans and binds with,
+// for passing to the generic GetOne/SelectAll/Iterate helpers.
+func (w *Wrapper) Mapper() *Mapper {
+	return w.mapper
 }
 
 // QueryRow is a wrapper for the QueryRow method
 func (w *Wrapper) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
-	return w.Pool.QueryRow(ctx, sql, args...)
+	return w.executor.QueryRow(ctx, sql, args...)
 }
 
 // Query is a wrapper for the Query method
 func (w *Wrapper) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
-	return w.Pool.Query(ctx, sql, args...)
+	return w.executor.Query(ctx, sql, args...)
 }
 
 // Exec is a wrapper for the Exec method
 func (w *Wrapper) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
-	return w.Pool.Exec(ctx, sql, args...)
+	return w.executor.Exec(ctx, sql, args...)
 }
 
 // Get executes a query that returns one row and scans it into the passed-in struct
 func (w *Wrapper) Get(ctx context.Context, dest interface{}, sqlStr string, args ...interface{}) error {
-	destVal := reflect.ValueOf(dest)
-	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Struct {
-		return errors.New("dest must be a pointer to a struct")
-	}
+	return Get(ctx, w.executor, w.mapper, dest, sqlStr, args...)
+}
 
-	// Get expected column names from the destination struct
-	columns, err := GetColumnNames(dest)
-	if err != nil {
-		return err
-	}
+// Select retrieves multiple results and scans them into a slice
+func (w *Wrapper) Select(ctx context.Context, dest interface{}, sqlStr string, args ...interface{}) error {
+	return Select(ctx, w.executor, w.mapper, dest, sqlStr, args...)
+}
 
-	// Get pointers to the struct fields
-	fields, err := StructFieldsPointers(dest, columns)
+// NamedQuery executes a query whose SQL contains `:field` placeholders,
+// binding them from arg (a struct or map[string]interface{}) via BindNamed.
+func (w *Wrapper) NamedQuery(ctx context.Context, sqlStr string, arg interface{}) (pgx.Rows, error) {
+	query, args, err := BindNamed(w.mapper, sqlStr, arg)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return w.Query(ctx, query, args...)
+}
 
-	// Execute the query
-	row := w.Pool.QueryRow(ctx, sqlStr, args...)
-
-	// Scan the data into the struct fields
-	if err := row.Scan(fields...); err != nil {
-		return err
+// NamedExec executes a statement whose SQL contains `:field` placeholders,
+// binding them from arg (a struct or map[string]interface{}) via BindNamed.
+func (w *Wrapper) NamedExec(ctx context.Context, sqlStr string, arg interface{}) (pgconn.CommandTag, error) {
+	query, args, err := BindNamed(w.mapper, sqlStr, arg)
+	if err != nil {
+		return pgconn.CommandTag{}, err
 	}
-
-	return nil
+	return w.Exec(ctx, query, args...)
 }
 
-// Select retrieves multiple results and scans them into a slice
-func (w *Wrapper) Select(ctx context.Context, dest interface{}, sqlStr string, args ...interface{}) error {
-	rows, err := w.Pool.Query(ctx, sqlStr, args...)
+// NamedGet is the named-parameter counterpart to Get: it binds sqlStr's
+// `:field` placeholders from arg before executing and scanning into dest.
+func (w *Wrapper) NamedGet(ctx context.Context, dest interface{}, sqlStr string, arg interface{}) error {
+	query, args, err := BindNamed(w.mapper, sqlStr, arg)
 	if err != nil {
 		return err
 	}
-	defer rows.Close()
-
-	destVal := reflect.ValueOf(dest)
-	if destVal.Kind() != reflect.Ptr || destVal.Elem().Kind() != reflect.Slice {
-		return errors.New("dest must be a pointer to a slice")
-	}
-
-	sliceVal := destVal.Elem()
-	elemType := sliceVal.Type().Elem()
-
-	ptrToStruct := false
-	if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct {
-		ptrToStruct = true
-		elemType = elemType.Elem()
-	} else if elemType.Kind() != reflect.Struct {
-		return errors.New("slice elements must be structs or pointers to structs")
-	}
-
-	fieldDescriptions := rows.FieldDescriptions()
-	columns := make([]string, len(fieldDescriptions))
-	for i, fd := range fieldDescriptions {
-		columns[i] = string(fd.Name)
-	}
-
-	for rows.Next() {
-		elemPtr := reflect.New(elemType)
-
-		fields, err := StructFieldsPointers(elemPtr.Interface(), columns)
-		if err != nil {
-			return err
-		}
-
-		if err := rows.Scan(fields...); err != nil {
-			return err
-		}
-
-		if ptrToStruct {
-			sliceVal.Set(reflect.Append(sliceVal, elemPtr))
-		} else {
-			sliceVal.Set(reflect.Append(sliceVal, elemPtr.Elem()))
-		}
-	}
+	return w.Get(ctx, dest, query, args...)
+}
 
-	if err := rows.Err(); err != nil {
+// NamedSelect is the named-parameter counterpart to Select: it binds sqlStr's
+// `:field` placeholders from arg before executing and scanning into dest.
+func (w *Wrapper) NamedSelect(ctx context.Context, dest interface{}, sqlStr string, arg interface{}) error {
+	query, args, err := BindNamed(w.mapper, sqlStr, arg)
+	if err != nil {
 		return err
 	}
-
-	return nil
+	return w.Select(ctx, dest, query, args...)
 }
 
 // Begin starts a transaction
@@ -134,7 +143,7 @@ func (w *Wrapper) Begin(ctx context.Context) (*TxWrapper, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &TxWrapper{Tx: tx}, nil
+	return newTxWrapper(tx, w.mapper, w.hooks), nil
 }
 
 // BeginTx starts a transaction with options
@@ -143,5 +152,5 @@ func (w *Wrapper) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (*TxWrap
 	if err != nil {
 		return nil, err
 	}
-	return &TxWrapper{Tx: tx}, nil
+	return newTxWrapper(tx, w.mapper, w.hooks), nil
 }